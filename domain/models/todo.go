@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Todoテーブルのモデル
+// UserIDはクライアントからの指定を許さず、認証済みユーザーのIDをサーバー側で設定する
+type Todo struct {
+	Id        int64          `json:"id" gorm:"primaryKey"`
+	UserID    int64          `json:"user_id" gorm:"index" binding:"-"`
+	Title     string         `json:"title" binding:"required,min=1,max=255"`
+	Detail    string         `json:"detail" binding:"max=1000"`
+	Point     int64          `json:"point" binding:"gte=0,lte=100"`
+	Done      bool           `json:"done"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Userテーブルのモデル
+type User struct {
+	Id           int64     `json:"id" gorm:"primaryKey"`
+	Email        string    `json:"email" gorm:"uniqueIndex" binding:"required,email"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
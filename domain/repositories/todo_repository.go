@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+)
+
+// ErrNotFound はid指定のレコードが存在しない場合に実装が返すエラー
+var ErrNotFound = errors.New("not exists id")
+
+// TodoListFilter は一覧取得時のページング/絞り込み条件
+// UserIDは呼び出し元(認証済みユーザー)を表し、常に絞り込みに使われる
+type TodoListFilter struct {
+	UserID int64
+	Limit  int
+	Offset int
+	Done   *bool
+	Q      string // title/detailへのLIKE検索
+}
+
+// TodoRepository はTodoの永続化を担う実装が満たすべきインターフェース
+// MySQL以外のストレージ(SQLite/PostgresやGORM/entなどのORM)に差し替えられるよう、
+// usecase層はこのインターフェースにのみ依存する
+// Get/Update/PartialUpdate/DeleteはuserIDで所有者を絞り込み、他ユーザーのTodoを参照/変更できないようにする
+type TodoRepository interface {
+	Create(todo *models.Todo) error
+	List(filter TodoListFilter) ([]models.Todo, int64, error)
+	Get(id, userID int64) (*models.Todo, error)
+	Update(todo *models.Todo) error
+	PartialUpdate(id, userID int64, fields map[string]interface{}) error
+	Delete(id, userID int64) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+)
+
+// ErrUserNotFound はメールアドレス/idに一致するユーザーが存在しない場合に実装が返すエラー
+var ErrUserNotFound = errors.New("not exists user")
+
+// ErrUserAlreadyExists はメールアドレスが既に登録済みの場合に実装が返すエラー
+var ErrUserAlreadyExists = errors.New("user already exists")
+
+// UserRepository はUserの永続化を担う実装が満たすべきインターフェース
+type UserRepository interface {
+	Create(user *models.User) error
+	GetByEmail(email string) (*models.User, error)
+	GetByID(id int64) (*models.User, error)
+}
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"fmt"
+	"os"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+)
+
+// NewMySQLConnection は環境変数DB_*からDSNを組み立ててMySQLへ接続し、
+// Todo/Userのスキーマをオートマイグレーションする
+func NewMySQLConnection() (*gorm.DB, error) {
+	// DSNの定義
+	dsn := fmt.Sprintf(
+		"%v:%v@tcp(%v:%v)/%v?charset=utf8mb4&parseTime=True&loc=Local",
+		os.Getenv("DB_USER"), os.Getenv("DB_PASS"), os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_NAME"),
+	)
+
+	// DB接続(GORM経由)
+	// TranslateError: trueにより、ドライバ固有のエラー(MySQLのunique制約違反など)を
+	// gorm.ErrDuplicatedKeyのような共通エラーへ変換してもらう
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, fmt.Errorf("fail to connect MySQL server: %w", err)
+	}
+
+	// 構造体のタグをもとにusers/todosテーブルを作成/更新する
+	if err := db.AutoMigrate(&models.User{}, &models.Todo{}); err != nil {
+		return nil, fmt.Errorf("fail to migrate schema: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+)
+
+// todoRepository はTodoRepositoryのGORM(MySQL)実装
+type todoRepository struct {
+	db *gorm.DB
+}
+
+// NewTodoRepository はGORMを利用するTodoRepositoryを生成する
+func NewTodoRepository(db *gorm.DB) *todoRepository {
+	return &todoRepository{db: db}
+}
+
+// Create はTodoをDBへ挿入し、AUTO_INCREMENTのIDをtodoに書き戻す
+func (r *todoRepository) Create(todo *models.Todo) error {
+	return r.db.Create(todo).Error
+}
+
+// List はfilterの条件(user_idを含む)でTodoの一覧をページングしながら取得し、条件に合う総件数も返す
+func (r *todoRepository) List(filter repositories.TodoListFilter) ([]models.Todo, int64, error) {
+	query := r.db.Model(&models.Todo{}).Where("user_id = ?", filter.UserID)
+
+	if filter.Done != nil {
+		query = query.Where("done = ?", *filter.Done)
+	}
+	if filter.Q != "" {
+		like := "%" + filter.Q + "%"
+		query = query.Where("title LIKE ? OR detail LIKE ?", like, like)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	todos := make([]models.Todo, 0)
+	if err := query.Limit(filter.Limit).Offset(filter.Offset).Find(&todos).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return todos, total, nil
+}
+
+// Get はid/userIDを指定してTodoを単一取得する
+func (r *todoRepository) Get(id, userID int64) (*models.Todo, error) {
+	var todo models.Todo
+	if err := r.db.Where("user_id = ?", userID).First(&todo, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &todo, nil
+}
+
+// Update はid/userIDを指定してTodoを更新する(CreatedAtなどの不変フィールドは既存値を維持する)
+func (r *todoRepository) Update(todo *models.Todo) error {
+	existing, err := r.Get(todo.Id, todo.UserID)
+	if err != nil {
+		return err
+	}
+
+	todo.CreatedAt = existing.CreatedAt
+	todo.DeletedAt = existing.DeletedAt
+
+	return r.db.Save(todo).Error
+}
+
+// PartialUpdate はid/userIDを指定してfieldsに含まれるカラムのみを更新する
+func (r *todoRepository) PartialUpdate(id, userID int64, fields map[string]interface{}) error {
+	res := r.db.Model(&models.Todo{}).Where("id = ? AND user_id = ?", id, userID).Updates(fields)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		if _, err := r.Get(id, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete はid/userIDを指定してTodoを削除する(論理削除)
+func (r *todoRepository) Delete(id, userID int64) error {
+	res := r.db.Where("user_id = ?", userID).Delete(&models.Todo{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
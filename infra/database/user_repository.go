@@ -0,0 +1,58 @@
+package database
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+)
+
+// userRepository はUserRepositoryのGORM(MySQL)実装
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository はGORMを利用するUserRepositoryを生成する
+func NewUserRepository(db *gorm.DB) *userRepository {
+	return &userRepository{db: db}
+}
+
+// Create はUserをDBへ挿入する
+func (r *userRepository) Create(user *models.User) error {
+	if err := r.db.Create(user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return repositories.ErrUserAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetByEmail はemailを指定してUserを単一取得する
+func (r *userRepository) GetByEmail(email string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("email = ?", email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// GetByID はidを指定してUserを単一取得する
+func (r *userRepository) GetByID(id int64) (*models.User, error) {
+	var user models.User
+	if err := r.db.First(&user, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
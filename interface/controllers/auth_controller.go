@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+	"github.com/poeMoon0416/todo-back/usecase/services"
+)
+
+// AuthController はユーザー登録/ログイン/トークンリフレッシュのGinハンドラをまとめる
+type AuthController struct {
+	service *services.AuthService
+}
+
+// NewAuthController はserviceに依存するAuthControllerを生成する
+func NewAuthController(service *services.AuthService) *AuthController {
+	return &AuthController{service: service}
+}
+
+type registerBody struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8,max=72"`
+}
+
+type loginBody struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type refreshBody struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// tokenResponse はログイン/リフレッシュ成功時のレスポンス
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresAt    int64  `json:"expires_at"`
+}
+
+// Register は新規ユーザーを登録する
+func (c *AuthController) Register(ctx *gin.Context) {
+	var body registerBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		respondBindError(ctx, err)
+		return
+	}
+
+	user, err := c.service.Register(body.Email, body.Password)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserAlreadyExists) {
+			respondError(ctx, http.StatusConflict, "user_already_exists", "email is already registered")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to register user")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusCreated, gin.H{"id": user.Id, "email": user.Email})
+}
+
+// Login はメールアドレス/パスワードを検証し、JWTを発行する
+func (c *AuthController) Login(ctx *gin.Context) {
+	var body loginBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		respondBindError(ctx, err)
+		return
+	}
+
+	pair, err := c.service.Login(body.Email, body.Password)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCredentials) {
+			respondError(ctx, http.StatusUnauthorized, "invalid_credentials", "invalid email or password")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to login")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, tokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresAt:    pair.ExpiresAt.Unix(),
+	})
+}
+
+// Refresh はリフレッシュトークンを検証し、新しいトークンの組を発行する
+func (c *AuthController) Refresh(ctx *gin.Context) {
+	var body refreshBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		respondBindError(ctx, err)
+		return
+	}
+
+	pair, err := c.service.Refresh(body.RefreshToken)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidToken) {
+			respondError(ctx, http.StatusUnauthorized, "invalid_token", "invalid or expired refresh token")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to refresh token")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, tokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresAt:    pair.ExpiresAt.Unix(),
+	})
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthController はliveness/readinessプローブ用のハンドラをまとめる
+type HealthController struct {
+	db *sql.DB
+}
+
+// NewHealthController はヘルスチェックでpingするDBに依存するHealthControllerを生成する
+func NewHealthController(db *sql.DB) *HealthController {
+	return &HealthController{db: db}
+}
+
+// Healthz はプロセスが生きていることだけを返すliveness probe
+func (c *HealthController) Healthz(ctx *gin.Context) {
+	ctx.IndentedJSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz はDBへの疎通を確認するreadiness probe
+func (c *HealthController) Readyz(ctx *gin.Context) {
+	if err := c.db.PingContext(ctx.Request.Context()); err != nil {
+		ctx.IndentedJSON(http.StatusServiceUnavailable, gin.H{"status": "unavailable"})
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, gin.H{"status": "ok"})
+}
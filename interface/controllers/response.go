@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// errorResponse はAPIが返すエラーレスポンスの共通形式
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// respondError はcode/messageのみを持つエラーレスポンスを返す
+func respondError(ctx *gin.Context, status int, code, message string) {
+	ctx.IndentedJSON(status, errorResponse{Error: errorBody{Code: code, Message: message}})
+}
+
+// respondBindError はBindJSON/ShouldBindJSONのエラーをフィールド単位のエラーレスポンスに変換する
+// validator.v10のValidationErrorsであればフィールドごとのメッセージを、それ以外は汎用メッセージを返す
+func respondBindError(ctx *gin.Context, err error) {
+	var validationErrs validator.ValidationErrors
+	if ok := asValidationErrors(err, &validationErrs); ok {
+		fields := make(map[string]string, len(validationErrs))
+		for _, fe := range validationErrs {
+			fields[fe.Field()] = fe.Tag()
+		}
+		ctx.IndentedJSON(http.StatusBadRequest, errorResponse{Error: errorBody{Code: "validation_error", Fields: fields}})
+		return
+	}
+
+	respondError(ctx, http.StatusBadRequest, "bad_request", "body must be todo's json")
+}
+
+func asValidationErrors(err error, target *validator.ValidationErrors) bool {
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		*target = ve
+		return true
+	}
+	return false
+}
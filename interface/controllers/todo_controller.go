@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+	"github.com/poeMoon0416/todo-back/interface/middleware"
+	"github.com/poeMoon0416/todo-back/usecase/services"
+)
+
+// TodoController はTodoに関するGinハンドラをまとめる
+type TodoController struct {
+	service *services.TodoService
+}
+
+// NewTodoController はserviceに依存するTodoControllerを生成する
+func NewTodoController(service *services.TodoService) *TodoController {
+	return &TodoController{service: service}
+}
+
+// userID はAuth()が設定した認証済みユーザーのIDをcontextから取り出す
+func userID(ctx *gin.Context) int64 {
+	return ctx.GetInt64(middleware.UserIDKey)
+}
+
+// Create はTodoを作成する(所有者は認証済みユーザーになる)
+func (c *TodoController) Create(ctx *gin.Context) {
+	// bodyのチェック(JSON形式/バリデーションタグをチェックしている, 余計なフィールド足りないフィールドは無視される)
+	var newTodo models.Todo
+	if err := ctx.ShouldBindJSON(&newTodo); err != nil {
+		respondBindError(ctx, err)
+		return
+	}
+	newTodo.UserID = userID(ctx)
+
+	if err := c.service.CreateTodo(&newTodo); err != nil {
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to create todo")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusCreated, newTodo)
+}
+
+// List はTodoを一覧表示する(?limit=&offset=&done=&qでページング/絞り込み可能)
+func (c *TodoController) List(ctx *gin.Context) {
+	filter := repositories.TodoListFilter{
+		UserID: userID(ctx),
+		Q:      ctx.Query("q"),
+	}
+
+	if limit, err := strconv.Atoi(ctx.Query("limit")); err == nil {
+		filter.Limit = limit
+	}
+	if offset, err := strconv.Atoi(ctx.Query("offset")); err == nil {
+		filter.Offset = offset
+	}
+	if doneStr := ctx.Query("done"); doneStr != "" {
+		done, err := strconv.ParseBool(doneStr)
+		if err != nil {
+			respondError(ctx, http.StatusBadRequest, "bad_request", "done must can parse string to bool")
+			return
+		}
+		filter.Done = &done
+	}
+
+	result, err := c.service.ListTodos(filter)
+	if err != nil {
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to exec query")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, gin.H{
+		"items":  result.Items,
+		"total":  result.Total,
+		"limit":  result.Limit,
+		"offset": result.Offset,
+	})
+}
+
+// Get はTodoをid指定で単一取得する
+func (c *TodoController) Get(ctx *gin.Context) {
+	// slugのチェック
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		respondError(ctx, http.StatusBadRequest, "bad_request", "id must can parse string to int64")
+		return
+	}
+
+	todo, err := c.service.GetTodo(id, userID(ctx))
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			respondError(ctx, http.StatusNotFound, "not_found", "not exists id")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to exec query")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, todo)
+}
+
+// Update はTodoをid指定で単一更新する(PUTなので指定がないフィールドは初期化される)
+func (c *TodoController) Update(ctx *gin.Context) {
+	// slugのチェック
+	var newTodo models.Todo
+	var err error
+	newTodo.Id, err = strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		respondError(ctx, http.StatusBadRequest, "bad_request", "id must can parse string to int64")
+		return
+	}
+
+	// bodyのチェック(JSON形式/バリデーションタグをチェックしている, 余計なフィールド足りないフィールドは無視される)
+	if err := ctx.ShouldBindJSON(&newTodo); err != nil {
+		respondBindError(ctx, err)
+		return
+	}
+	newTodo.UserID = userID(ctx)
+
+	if err := c.service.UpdateTodo(&newTodo); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			respondError(ctx, http.StatusNotFound, "not_found", "not exists id")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to exec query")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, newTodo)
+}
+
+// todoPatchBody はPATCH /todos/:idのリクエストボディ
+// ポインタ型にすることでフィールドが指定されたかどうかを区別する
+type todoPatchBody struct {
+	Title  *string `json:"title" binding:"omitempty,min=1,max=255"`
+	Detail *string `json:"detail" binding:"omitempty,max=1000"`
+	Point  *int64  `json:"point" binding:"omitempty,gte=0,lte=100"`
+	Done   *bool   `json:"done"`
+}
+
+// Patch はTodoをid指定で部分更新する(指定されたフィールドのみ更新される)
+func (c *TodoController) Patch(ctx *gin.Context) {
+	// slugのチェック
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		respondError(ctx, http.StatusBadRequest, "bad_request", "id must can parse string to int64")
+		return
+	}
+
+	// bodyのチェック
+	var body todoPatchBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		respondBindError(ctx, err)
+		return
+	}
+
+	fields := make(map[string]interface{})
+	if body.Title != nil {
+		fields["title"] = *body.Title
+	}
+	if body.Detail != nil {
+		fields["detail"] = *body.Detail
+	}
+	if body.Point != nil {
+		fields["point"] = *body.Point
+	}
+	if body.Done != nil {
+		fields["done"] = *body.Done
+	}
+
+	if err := c.service.PartialUpdateTodo(id, userID(ctx), fields); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			respondError(ctx, http.StatusNotFound, "not_found", "not exists id")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to exec query")
+		return
+	}
+
+	todo, err := c.service.GetTodo(id, userID(ctx))
+	if err != nil {
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to exec query")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, todo)
+}
+
+// Delete はTodoをid指定で単一削除する
+func (c *TodoController) Delete(ctx *gin.Context) {
+	// slugのチェック
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		respondError(ctx, http.StatusBadRequest, "bad_request", "id must can parse string to int64")
+		return
+	}
+
+	if err := c.service.DeleteTodo(id, userID(ctx)); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			respondError(ctx, http.StatusNotFound, "not_found", "not exists id")
+			return
+		}
+		respondError(ctx, http.StatusInternalServerError, "internal_error", "fail to exec query")
+		return
+	}
+
+	// 正常系
+	ctx.IndentedJSON(http.StatusOK, gin.H{"id": id})
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/poeMoon0416/todo-back/usecase/services"
+)
+
+// UserIDKey はcontextに認証済みユーザーのIDを格納する際のキー
+const UserIDKey = "user_id"
+
+// Auth はAuthorization: Bearer <token>を検証し、ユーザーIDをcontextへ格納する
+// トークンが無い/不正な場合は401を返してハンドラの実行を中断する
+func Auth(authService *services.AuthService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		tokenString, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || tokenString == "" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": gin.H{"code": "unauthorized", "message": "missing bearer token"},
+			})
+			return
+		}
+
+		userID, err := authService.ParseAccessToken(tokenString)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": gin.H{"code": "unauthorized", "message": "invalid or expired token"},
+			})
+			return
+		}
+
+		ctx.Set(UserIDKey, userID)
+		ctx.Next()
+	}
+}
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// CORS はブラウザ製フロントエンドからのクロスオリジンリクエストを許可する
+func CORS() gin.HandlerFunc {
+	return cors.New(cors.Config{
+		AllowAllOrigins: true,
+		AllowMethods:    []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:    []string{"Origin", "Content-Type", "Authorization", RequestIDHeader},
+		MaxAge:          12 * time.Hour,
+	})
+}
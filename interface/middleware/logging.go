@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Logging はリクエストごとに構造化ログ(JSON)を出力する
+// X-Request-IDをログに含めることで、RequestID()が発行した相関IDと突き合わせられる
+func Logging(logger *zap.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		logger.Info("request",
+			zap.String("request_id", ctx.GetString(RequestIDHeader)),
+			zap.String("method", ctx.Request.Method),
+			zap.String("path", ctx.Request.URL.Path),
+			zap.Int("status", ctx.Writer.Status()),
+			zap.Duration("latency", time.Since(start)),
+		)
+	}
+}
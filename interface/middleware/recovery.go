@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Recovery はpanicを捕捉し、他のハンドラと同じ{"error": {...}}形式でレスポンスを返す
+func Recovery(logger *zap.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("panic recovered",
+					zap.String("request_id", ctx.GetString(RequestIDHeader)),
+					zap.Any("error", r),
+				)
+
+				ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": gin.H{
+						"code":    "internal_error",
+						"message": "internal server error",
+					},
+				})
+			}
+		}()
+
+		ctx.Next()
+	}
+}
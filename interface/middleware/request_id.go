@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader はリクエストの相関IDを運ぶヘッダー名
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID はクライアントから送られたX-Request-IDを引き継ぎ、なければ新規採番してcontextとレスポンスヘッダーに載せる
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		ctx.Set(RequestIDHeader, requestID)
+		ctx.Header(RequestIDHeader, requestID)
+		ctx.Next()
+	}
+}
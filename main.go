@@ -1,248 +1,188 @@
 package main
 
 import (
-	"database/sql"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
-	"strconv"
+	"os/signal"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-sql-driver/mysql"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+
+	"github.com/poeMoon0416/todo-back/infra/database"
+	"github.com/poeMoon0416/todo-back/interface/controllers"
+	"github.com/poeMoon0416/todo-back/interface/middleware"
+	"github.com/poeMoon0416/todo-back/usecase/services"
 )
 
-// Todoテーブルのモデル
-type Todo struct {
-	Id     int64  `json:"id"`
-	Title  string `json:"title"`
-	Detail string `json:"detail"`
-	Point  int64  `json:"point"`
-	Done   bool   `json:"done"`
-}
+// シャットダウン時に既存のリクエストを処理しきるまで待つ猶予時間
+const shutdownTimeout = 10 * time.Second
 
-// DBへの接続、どの関数からでもアクセスできるようにグローバル変数
-var db *sql.DB
+// HS256の署名鍵として安全に使える最低文字数
+const minJWTSecretLen = 32
 
 func main() {
-	// DSNの定義
-	cfg := mysql.Config{
-		User:   os.Getenv("DB_USER"),
-		Passwd: os.Getenv("DB_PASS"),
-		Net:    "tcp",
-		Addr:   fmt.Sprintf("%v:%v", os.Getenv("DB_HOST"), os.Getenv("DB_PORT")),
-		DBName: os.Getenv("DB_NAME"),
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logger.Sync()
+
+	// バリデーションエラーのfieldsをGoのフィールド名ではなくjsonタグ名で返すようにする
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+			name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
 	}
 
-	// DB接続(接続できなくなった場合再接続を試み続ける)
-	var err error
-	db, err = sql.Open("mysql", cfg.FormatDSN())
+	// DB接続
+	db, err := database.NewMySQLConnection()
 	if err != nil {
-		log.Fatalf("fail to connect MySQL server: %v", err)
+		log.Fatal(err)
 	}
 
-	// 最初のDB接続の失敗時にエラーを出す用(sql.Open()だけだと接続時エラーでない)
-	if err := db.Ping(); err != nil {
-		log.Fatalf("fail to ping MySQL server: %v", err)
+	// JWT署名鍵が未設定/短すぎる場合は起動させない(空文字署名によるトークン偽造を防ぐ)
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if len(jwtSecret) < minJWTSecretLen {
+		log.Fatalf("JWT_SECRET must be set to at least %d characters", minJWTSecretLen)
 	}
 
+	// 依存関係の組み立て(repository -> service -> controller)
+	userRepository := database.NewUserRepository(db)
+	authService := services.NewAuthService(userRepository, []byte(jwtSecret))
+	authController := controllers.NewAuthController(authService)
+
+	todoRepository := database.NewTodoRepository(db)
+	todoService := services.NewTodoService(todoRepository)
+	todoController := controllers.NewTodoController(todoService)
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	healthController := controllers.NewHealthController(sqlDB)
+
 	// エントリーポイントでルータを実行
-	router := gin.Default()
+	router := gin.New()
+	router.Use(middleware.RequestID(), middleware.Logging(logger), middleware.Recovery(logger), middleware.CORS())
+
+	// liveness/readiness probe(docker-compose/Kubernetesのヘルスチェックから叩かれる)
+	router.GET("/healthz", healthController.Healthz)
+	router.GET("/readyz", healthController.Readyz)
+
+	/*
+		curl --request 'POST' \
+		--url "http://${AP_HOST}:${AP_PORT}/auth/register" \
+		--header 'Content-Type: application/json' \
+		--data '{"email": "taro@example.com", "password": "password123"}' \
+		--include
+	*/
+	router.POST("/auth/register", authController.Register)
+	/*
+		curl --request 'POST' \
+		--url "http://${AP_HOST}:${AP_PORT}/auth/login" \
+		--header 'Content-Type: application/json' \
+		--data '{"email": "taro@example.com", "password": "password123"}' \
+		--include
+	*/
+	router.POST("/auth/login", authController.Login)
+	/*
+		curl --request 'POST' \
+		--url "http://${AP_HOST}:${AP_PORT}/auth/refresh" \
+		--header 'Content-Type: application/json' \
+		--data '{"refresh_token": "..."}' \
+		--include
+	*/
+	router.POST("/auth/refresh", authController.Refresh)
+
+	// /todos以下は認証必須、Todoはuser_idで所有者ごとに分離される
+	todos := router.Group("/todos", middleware.Auth(authService))
 	// 以下テストコマンド
 	/*
 		curl --request 'POST' \
 		--url "http://${AP_HOST}:${AP_PORT}/todos" \
 		--header 'Content-Type: application/json' \
+		--header 'Authorization: Bearer <access_token>' \
 		--data '{"title": "アプリの完成", "detail": "Denoを頑張って学ぶ必要がある。", "point": 1, "done": true}' \
 		--include
 	*/
-	router.POST("/todos", createTodo)
+	todos.POST("", todoController.Create)
 	/*
 		curl --request 'GET' \
 		--url "http://${AP_HOST}:${AP_PORT}/todos" \
-		--header 'Content-Type: application/json' \
+		--header 'Authorization: Bearer <access_token>' \
 		--include
 	*/
-	router.GET("/todos", listTodos)
+	todos.GET("", todoController.List)
 	/*
 		curl --request 'GET' \
 		--url "http://${AP_HOST}:${AP_PORT}/todos/2" \
-		--header 'Content-Type: application/json' \
+		--header 'Authorization: Bearer <access_token>' \
 		--include
 	*/
-	router.GET("/todos/:id", getTodo)
+	todos.GET("/:id", todoController.Get)
 	/*
 		curl --request 'PUT' \
 		--url "http://${AP_HOST}:${AP_PORT}/todos/2" \
 		--header 'Content-Type: application/json' \
+		--header 'Authorization: Bearer <access_token>' \
 		--data '{"title": "アプリの完成", "detail": "Node.jsとGoとMySQLを頑張って学ぶ必要がある。", "point": 3, "done": true}' \
 		--include
 	*/
-	router.PUT("/todos/:id", updateTodo)
+	todos.PUT("/:id", todoController.Update)
 	/*
-		curl --request 'DELETE' \
+		curl --request 'PATCH' \
 		--url "http://${AP_HOST}:${AP_PORT}/todos/2" \
 		--header 'Content-Type: application/json' \
+		--header 'Authorization: Bearer <access_token>' \
+		--data '{"done": true}' \
 		--include
 	*/
-	router.DELETE("/todos/:id", deleteTodo)
-	// APサーバのipアドレス(自身以外が可能) or localhost(127.0.0.1)
-	router.Run(fmt.Sprintf("%v:%v", os.Getenv("AP_HOST"), os.Getenv("AP_PORT")))
-}
-
-// Todoを作成
-func createTodo(ctx *gin.Context) {
-	// bodyのチェック(JSON形式で型があっているかチェックしている, 余計なフィールド足りないフィールドは無視される)
-	var newTodo Todo
-	if err := ctx.BindJSON(&newTodo); err != nil {
-		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"message": "body must be todo's json"})
-		return
-	}
-
-	// DBへの挿入
-	res, err := db.Exec("INSERT INTO todos(title, detail, point, done) VALUES(?, ?, ?, ?)", newTodo.Title, newTodo.Detail, newTodo.Point, newTodo.Done)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to create todo"})
-		return
-	}
+	todos.PATCH("/:id", todoController.Patch)
+	/*
+		curl --request 'DELETE' \
+		--url "http://${AP_HOST}:${AP_PORT}/todos/2" \
+		--header 'Authorization: Bearer <access_token>' \
+		--include
+	*/
+	todos.DELETE("/:id", todoController.Delete)
 
-	// int64でAUTO_INCREMENTのIDを取得
-	newTodo.Id, err = res.LastInsertId()
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to get last insert id"})
-		return
+	// APサーバのipアドレス(自身以外が可能) or localhost(127.0.0.1)
+	srv := &http.Server{
+		Addr:    fmt.Sprintf("%v:%v", os.Getenv("AP_HOST"), os.Getenv("AP_PORT")),
+		Handler: router,
 	}
 
-	// 正常系
-	ctx.IndentedJSON(http.StatusCreated, newTodo)
-}
-
-// Todoを一覧表示
-func listTodos(ctx *gin.Context) {
-	// クエリ実行
-	rows, err := db.Query("SELECT * FROM todos")
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to exec query"})
-		return
-	}
-	defer rows.Close()
-
-	// 1行ずつ読み出し
-	todos := make([]Todo, 0)
-	for rows.Next() {
-		var todo Todo
-		if err := rows.Scan(&todo.Id, &todo.Title, &todo.Detail, &todo.Point, &todo.Done); err != nil {
-			ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to scan columns to struct"})
-			return
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatal("fail to listen and serve", zap.Error(err))
 		}
-		todos = append(todos, todo)
-	}
+	}()
 
-	// rows.Next()がエラーで抜けてきた場合
-	if rows.Err() != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to read rows"})
-		return
-	}
+	// SIGINT/SIGTERMを受け取るまで待機し、受け取ったら処理中のリクエストを終えてから終了する
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
 
-	// 正常系
-	ctx.IndentedJSON(http.StatusOK, todos)
-}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
 
-// Todoをid指定で単一取得
-func getTodo(ctx *gin.Context) {
-	// slugのチェック
-	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"message": "id must can parse string to int64"})
-		return
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("fail to shutdown server gracefully", zap.Error(err))
 	}
-
-	// クエリ実行
-	var todo Todo
-	row := db.QueryRow("SELECT * FROM todos WHERE id = ?", id)
-	if err := row.Scan(&todo.Id, &todo.Title, &todo.Detail, &todo.Point, &todo.Done); err != nil {
-		ctx.IndentedJSON(http.StatusNotFound, gin.H{"message": "not exists id"})
-		return
-	}
-
-	// 正常系
-	ctx.IndentedJSON(http.StatusOK, todo)
-}
-
-// Todoをid指定で単一更新(PUTなので指定がないフィールドは初期化される)
-func updateTodo(ctx *gin.Context) {
-	// slugのチェック
-	var newTodo Todo
-	var err error
-	newTodo.Id, err = strconv.ParseInt(ctx.Param("id"), 10, 64)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"message": "id must can parse string to int64"})
-		return
-	}
-
-	// bodyのチェック(JSON形式で型があっているかチェックしている, 余計なフィールド足りないフィールドは無視される)
-	if err := ctx.BindJSON(&newTodo); err != nil {
-		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"message": "body must be todo's json"})
-		return
+	if err := sqlDB.Close(); err != nil {
+		logger.Error("fail to close db connection", zap.Error(err))
 	}
-
-	// 更新クエリ実行
-	var res sql.Result
-	res, err = db.Exec("UPDATE todos SET title = ?, detail = ?, point = ?, done = ? WHERE id = ?", newTodo.Title, newTodo.Detail, newTodo.Point, newTodo.Done, newTodo.Id)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to exec query"})
-		return
-	}
-
-	// 更新行数の取得
-	var cnt int64
-	cnt, err = res.RowsAffected()
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to get affected row count"})
-		return
-	}
-
-	// 1行も消していない場合
-	if cnt == 0 {
-		ctx.IndentedJSON(http.StatusNotFound, gin.H{"message": "not exists id"})
-		return
-	}
-
-	// 正常系
-	ctx.IndentedJSON(http.StatusOK, newTodo)
-}
-
-// Todoをid指定で単一削除
-func deleteTodo(ctx *gin.Context) {
-	// slugのチェック
-	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"message": "id must can parse string to int64"})
-		return
-	}
-
-	// 削除クエリ実行
-	var res sql.Result
-	res, err = db.Exec("DELETE FROM todos WHERE id = ?", id)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to exec query"})
-		return
-	}
-
-	// 削除行数の取得
-	var cnt int64
-	cnt, err = res.RowsAffected()
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "fail to get affected row count"})
-		return
-	}
-
-	// 1行も消していない場合
-	if cnt == 0 {
-		ctx.IndentedJSON(http.StatusNotFound, gin.H{"message": "not exists id"})
-		return
-	}
-
-	// 正常系
-	ctx.IndentedJSON(http.StatusOK, gin.H{"id": id})
 }
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+)
+
+// アクセストークン/リフレッシュトークンの有効期限
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// ErrInvalidCredentials はメールアドレス/パスワードの組み合わせが正しくない場合に返すエラー
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+// ErrInvalidToken はトークンが不正(改ざん/期限切れ/種別違い)な場合に返すエラー
+var ErrInvalidToken = errors.New("invalid token")
+
+// tokenClaims はアクセストークン/リフレッシュトークン共通のクレーム
+type tokenClaims struct {
+	UserID int64  `json:"user_id"`
+	Type   string `json:"type"`
+	jwt.RegisteredClaims
+}
+
+// TokenPair はログイン/リフレッシュ時に発行するトークンの組
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// AuthService はユーザー登録/ログイン/トークンの発行・検証を行う
+type AuthService struct {
+	repo   repositories.UserRepository
+	secret []byte
+}
+
+// NewAuthService はrepoとJWT署名用のsecretに依存するAuthServiceを生成する
+func NewAuthService(repo repositories.UserRepository, secret []byte) *AuthService {
+	return &AuthService{repo: repo, secret: secret}
+}
+
+// Register はメールアドレス/パスワードで新規ユーザーを登録する
+func (s *AuthService) Register(email, password string) (*models.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &models.User{Email: email, PasswordHash: string(hash)}
+	if err := s.repo.Create(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Login はメールアドレス/パスワードを検証し、アクセストークン/リフレッシュトークンを発行する
+func (s *AuthService) Login(email, password string) (*TokenPair, error) {
+	user, err := s.repo.GetByEmail(email)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.issueTokenPair(user.Id)
+}
+
+// Refresh はリフレッシュトークンを検証し、新しいアクセストークン/リフレッシュトークンを発行する
+func (s *AuthService) Refresh(refreshToken string) (*TokenPair, error) {
+	claims, err := s.parseToken(refreshToken, "refresh")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokenPair(claims.UserID)
+}
+
+// ParseAccessToken はアクセストークンを検証し、含まれるユーザーIDを返す(認証ミドルウェアから利用する)
+func (s *AuthService) ParseAccessToken(accessToken string) (int64, error) {
+	claims, err := s.parseToken(accessToken, "access")
+	if err != nil {
+		return 0, err
+	}
+
+	return claims.UserID, nil
+}
+
+func (s *AuthService) issueTokenPair(userID int64) (*TokenPair, error) {
+	now := time.Now()
+	expiresAt := now.Add(accessTokenTTL)
+
+	accessToken, err := s.signToken(userID, "access", now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := s.signToken(userID, "refresh", now, now.Add(refreshTokenTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{AccessToken: accessToken, RefreshToken: refreshToken, ExpiresAt: expiresAt}, nil
+}
+
+func (s *AuthService) signToken(userID int64, tokenType string, issuedAt, expiresAt time.Time) (string, error) {
+	claims := tokenClaims{
+		UserID: userID,
+		Type:   tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *AuthService) parseToken(tokenString, wantType string) (*tokenClaims, error) {
+	claims := &tokenClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	})
+	if err != nil || !token.Valid || claims.Type != wantType {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
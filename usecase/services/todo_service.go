@@ -0,0 +1,77 @@
+package services
+
+import (
+	"github.com/poeMoon0416/todo-back/domain/models"
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+)
+
+// TodoService はTodoに関するユースケースを提供する
+// リポジトリの実装には依存せず、インターフェースにのみ依存する
+type TodoService struct {
+	repo repositories.TodoRepository
+}
+
+// NewTodoService はrepoに依存するTodoServiceを生成する
+func NewTodoService(repo repositories.TodoRepository) *TodoService {
+	return &TodoService{repo: repo}
+}
+
+// ListTodos一回あたりのデフォルト/上限件数
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ListTodosResult はページング済みの一覧結果
+type ListTodosResult struct {
+	Items  []models.Todo
+	Total  int64
+	Limit  int
+	Offset int
+}
+
+// CreateTodo はTodoを作成する
+func (s *TodoService) CreateTodo(todo *models.Todo) error {
+	return s.repo.Create(todo)
+}
+
+// ListTodos はfilterの条件でTodoの一覧をページングして取得する
+// limit/offsetが未指定(0以下)の場合はデフォルト値を用い、上限を超える場合は丸める
+func (s *TodoService) ListTodos(filter repositories.TodoListFilter) (*ListTodosResult, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	items, total, err := s.repo.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTodosResult{Items: items, Total: total, Limit: filter.Limit, Offset: filter.Offset}, nil
+}
+
+// GetTodo はid/userIDを指定してTodoを単一取得する
+func (s *TodoService) GetTodo(id, userID int64) (*models.Todo, error) {
+	return s.repo.Get(id, userID)
+}
+
+// UpdateTodo はidを指定してTodoを更新する(todo.UserIDで所有者を確認する)
+func (s *TodoService) UpdateTodo(todo *models.Todo) error {
+	return s.repo.Update(todo)
+}
+
+// PartialUpdateTodo はid/userIDを指定してfieldsに含まれるカラムのみを更新する
+func (s *TodoService) PartialUpdateTodo(id, userID int64, fields map[string]interface{}) error {
+	return s.repo.PartialUpdate(id, userID, fields)
+}
+
+// DeleteTodo はid/userIDを指定してTodoを削除する
+func (s *TodoService) DeleteTodo(id, userID int64) error {
+	return s.repo.Delete(id, userID)
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/poeMoon0416/todo-back/domain/models"
+	"github.com/poeMoon0416/todo-back/domain/repositories"
+)
+
+// mockTodoRepository はrepositories.TodoRepositoryを満たすテスト用のインメモリ実装
+type mockTodoRepository struct {
+	listFilter repositories.TodoListFilter
+}
+
+func (m *mockTodoRepository) Create(todo *models.Todo) error { return nil }
+
+func (m *mockTodoRepository) List(filter repositories.TodoListFilter) ([]models.Todo, int64, error) {
+	m.listFilter = filter
+	return nil, 0, nil
+}
+
+func (m *mockTodoRepository) Get(id, userID int64) (*models.Todo, error) { return nil, nil }
+
+func (m *mockTodoRepository) Update(todo *models.Todo) error { return nil }
+
+func (m *mockTodoRepository) PartialUpdate(id, userID int64, fields map[string]interface{}) error {
+	return nil
+}
+
+func (m *mockTodoRepository) Delete(id, userID int64) error { return nil }
+
+func TestListTodos_DefaultsAndClampsLimit(t *testing.T) {
+	cases := []struct {
+		name      string
+		inLimit   int
+		wantLimit int
+	}{
+		{"unset uses default", 0, defaultListLimit},
+		{"negative uses default", -1, defaultListLimit},
+		{"over max is clamped", maxListLimit + 50, maxListLimit},
+		{"within range is kept", 10, 10},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &mockTodoRepository{}
+			service := NewTodoService(repo)
+
+			result, err := service.ListTodos(repositories.TodoListFilter{UserID: 1, Limit: tc.inLimit})
+			if err != nil {
+				t.Fatalf("ListTodos returned error: %v", err)
+			}
+			if result.Limit != tc.wantLimit {
+				t.Errorf("Limit = %d, want %d", result.Limit, tc.wantLimit)
+			}
+			if repo.listFilter.Limit != tc.wantLimit {
+				t.Errorf("repo received Limit = %d, want %d", repo.listFilter.Limit, tc.wantLimit)
+			}
+		})
+	}
+}
+
+func TestListTodos_NegativeOffsetResetToZero(t *testing.T) {
+	repo := &mockTodoRepository{}
+	service := NewTodoService(repo)
+
+	result, err := service.ListTodos(repositories.TodoListFilter{UserID: 1, Offset: -5})
+	if err != nil {
+		t.Fatalf("ListTodos returned error: %v", err)
+	}
+	if result.Offset != 0 {
+		t.Errorf("Offset = %d, want 0", result.Offset)
+	}
+}